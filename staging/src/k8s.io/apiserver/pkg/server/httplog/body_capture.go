@@ -0,0 +1,162 @@
+/*
+Copyright 2014 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// defaultBodyCaptureVerbosity is the klog verbosity level at or above which
+// WithBodyCapture actually tees bodies into the log record. Below it, the
+// request and response are passed through untouched so the feature costs
+// nothing in normal operation.
+const defaultBodyCaptureVerbosity = 6
+
+// defaultBodyCaptureMaxBytes is the default per-body cap used by
+// WithBodyCapture.
+const defaultBodyCaptureMaxBytes = 4 * 1024
+
+// DefaultTextualContentTypes are logged as-is by WithBodyCapture; anything
+// else is base64-encoded before it reaches the log sink.
+var DefaultTextualContentTypes = []string{
+	"application/json",
+	"text/plain",
+	"text/html",
+	"application/xml",
+	"text/xml",
+}
+
+// bodyCapture holds the configuration assembled by WithBodyCapture and
+// WithBodyRedactor.
+type bodyCapture struct {
+	maxBytes     int
+	contentTypes []string
+	redactor     func([]byte, http.Header) []byte
+}
+
+// WithBodyCapture returns a WithLogging Option that, at klog verbosity >= 6,
+// tees the request and response bodies into bounded buffers (default cap 4
+// KiB, overridden by maxBytes) and includes them in the logged record as
+// reqBody/respBody. contentTypes lists the content-types that are safe to
+// log as-is (see DefaultTextualContentTypes); anything else is
+// base64-encoded. Request-body capture stops recording once the cap is hit
+// so large uploads don't blow memory, and only tees lazily as the handler
+// reads the body, so handlers that never read it pay no cost.
+func WithBodyCapture(maxBytes int, contentTypes []string) Option {
+	if maxBytes <= 0 {
+		maxBytes = defaultBodyCaptureMaxBytes
+	}
+	return func(o *options) {
+		o.bodyCapture = &bodyCapture{
+			maxBytes:     maxBytes,
+			contentTypes: contentTypes,
+		}
+	}
+}
+
+// WithBodyRedactor sets a hook that runs over a captured body (and the
+// headers of the request or response it came from) before it is logged, so
+// operators can strip Authorization-bearing payloads or similar secrets from
+// the log sink. It only takes effect when it comes after WithBodyCapture in
+// the option list; given before it, or without it, it has no effect.
+func WithBodyRedactor(redactor func(body []byte, header http.Header) []byte) Option {
+	return func(o *options) {
+		if o.bodyCapture != nil {
+			o.bodyCapture.redactor = redactor
+		}
+	}
+}
+
+func (b *bodyCapture) isTextual(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+	for _, ct := range b.contentTypes {
+		if mediaType == ct {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bodyCapture) encode(raw []byte, header http.Header) string {
+	body := raw
+	if b.redactor != nil {
+		body = b.redactor(body, header)
+	}
+	if b.isTextual(header.Get("Content-Type")) {
+		return string(body)
+	}
+	return base64.StdEncoding.EncodeToString(body)
+}
+
+// cappedBuffer is a bytes.Buffer that silently stops accepting new bytes once
+// it has buffered max, instead of growing without bound.
+type cappedBuffer struct {
+	buf bytes.Buffer
+	max int
+}
+
+func (c *cappedBuffer) Write(p []byte) (int, error) {
+	remaining := c.max - c.buf.Len()
+	if remaining < 0 {
+		remaining = 0
+	}
+	if len(p) > remaining {
+		p = p[:remaining]
+	}
+	if len(p) > 0 {
+		c.buf.Write(p)
+	}
+	return len(p), nil
+}
+
+// teeReadCloser tees everything Read from the wrapped body into buf. The
+// copy only happens as the handler reads, so a handler that never reads the
+// request body never pays for the capture.
+type teeReadCloser struct {
+	io.ReadCloser
+	buf *cappedBuffer
+}
+
+func (t *teeReadCloser) Read(p []byte) (int, error) {
+	n, err := t.ReadCloser.Read(p)
+	if n > 0 {
+		t.buf.Write(p[:n])
+	}
+	return n, err
+}
+
+// captureBodies wires up request and response body capture for this request,
+// returning the (possibly) modified request whose Body now tees into
+// rl.reqBodyBuf.
+func (rl *respLogger) captureBodies(req *http.Request, cfg *bodyCapture) *http.Request {
+	rl.bodyCaptureCfg = cfg
+	rl.reqBodyBuf = &cappedBuffer{max: cfg.maxBytes}
+	rl.respBodyBuf = &cappedBuffer{max: cfg.maxBytes}
+
+	if req.Body != nil {
+		body := req.Body
+		req.Body = &teeReadCloser{ReadCloser: body, buf: rl.reqBodyBuf}
+	}
+	return req
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package httplog
 
+//go:generate go run generate_wrappers.go
+
 import (
 	"bufio"
 	"context"
@@ -58,6 +60,13 @@ type respLogger struct {
 	addedInfo          strings.Builder
 	addedKeyValuePairs []interface{}
 	startTime          time.Time
+	requestID          string
+
+	bodyCaptureCfg *bodyCapture
+	reqBodyBuf     *cappedBuffer
+	respBodyBuf    *cappedBuffer
+
+	sinks []Sink
 
 	captureErrorOutput bool
 
@@ -87,8 +96,21 @@ func DefaultStacktracePred(status int) bool {
 	return (status < http.StatusOK || status >= http.StatusInternalServerError) && status != http.StatusSwitchingProtocols
 }
 
+// Option configures the behavior of WithLogging.
+type Option func(*options)
+
+type options struct {
+	bodyCapture *bodyCapture
+	sinks       []Sink
+}
+
 // WithLogging wraps the handler with logging.
-func WithLogging(handler http.Handler, pred StacktracePred) http.Handler {
+func WithLogging(handler http.Handler, pred StacktracePred, opts ...Option) http.Handler {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		ctx := req.Context()
 		if old := respLoggerFromRequest(req); old != nil {
@@ -103,7 +125,22 @@ func WithLogging(handler http.Handler, pred StacktracePred) http.Handler {
 		var rl *respLogger
 		rl, w = newLoggedWithStartTime(req, w, startTime)
 		rl.StacktraceWhen(pred)
-		req = req.WithContext(context.WithValue(ctx, respLoggerContextKey, rl))
+		rl.sinks = o.sinks
+
+		requestID := req.Header.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = RequestIDSource(req)
+		}
+		rl.requestID = requestID
+		w.Header().Set("X-Request-ID", requestID)
+
+		if o.bodyCapture != nil && klog.V(defaultBodyCaptureVerbosity).Enabled() {
+			req = rl.captureBodies(req, o.bodyCapture)
+		}
+
+		ctx = context.WithValue(ctx, respLoggerContextKey, rl)
+		ctx = context.WithValue(ctx, RequestIDKey, requestID)
+		req = req.WithContext(ctx)
 
 		if klog.V(3).Enabled() {
 			defer rl.Log()
@@ -133,7 +170,7 @@ func newLoggedWithStartTime(req *http.Request, w http.ResponseWriter, startTime
 		logStacktracePred: DefaultStacktracePred,
 	}
 
-	rw := responsewriter.WrapForHTTP1Or2(logger)
+	rw := wrapResponseWriter(logger)
 	return logger, rw
 }
 
@@ -221,30 +258,45 @@ func (rl *respLogger) Log() {
 	// mark APPLY requests and WATCH requests correctly.
 	verb = metrics.CleanVerb(verb, rl.req)
 
-	keysAndValues := []interface{}{
-		"verb", verb,
-		"URI", rl.req.RequestURI,
-		"latency", latency,
-		"userAgent", rl.req.UserAgent(),
-		"audit-ID", auditID,
-		"srcIP", rl.req.RemoteAddr,
+	extra := make(map[string]interface{}, len(rl.addedKeyValuePairs)/2)
+	for i := 0; i+1 < len(rl.addedKeyValuePairs); i += 2 {
+		if key, ok := rl.addedKeyValuePairs[i].(string); ok {
+			extra[key] = rl.addedKeyValuePairs[i+1]
+		}
 	}
-	keysAndValues = append(keysAndValues, rl.addedKeyValuePairs...)
-
-	if rl.hijacked {
-		keysAndValues = append(keysAndValues, "hijacked", true)
-	} else {
-		keysAndValues = append(keysAndValues, "resp", rl.status)
-		if len(rl.statusStack) > 0 {
-			keysAndValues = append(keysAndValues, "statusStack", rl.statusStack)
+	if rl.bodyCaptureCfg != nil {
+		if rl.reqBodyBuf != nil && rl.reqBodyBuf.buf.Len() > 0 {
+			extra["reqBody"] = rl.bodyCaptureCfg.encode(rl.reqBodyBuf.buf.Bytes(), rl.req.Header)
 		}
-		info := rl.addedInfo.String()
-		if len(info) > 0 {
-			keysAndValues = append(keysAndValues, "addedInfo", info)
+		if rl.respBodyBuf != nil && rl.respBodyBuf.buf.Len() > 0 {
+			extra["respBody"] = rl.bodyCaptureCfg.encode(rl.respBodyBuf.buf.Bytes(), rl.w.Header())
 		}
 	}
 
-	klog.InfoSDepth(1, "HTTP", keysAndValues...)
+	record := Record{
+		Timestamp:   rl.startTime,
+		Verb:        verb,
+		Method:      rl.req.Method,
+		URI:         rl.req.RequestURI,
+		Latency:     latency,
+		UserAgent:   rl.req.UserAgent(),
+		AuditID:     auditID,
+		RequestID:   rl.requestID,
+		SrcIP:       rl.req.RemoteAddr,
+		Status:      rl.status,
+		Hijacked:    rl.hijacked,
+		StatusStack: rl.statusStack,
+		AddedInfo:   rl.addedInfo.String(),
+		Extra:       extra,
+	}
+
+	sinks := rl.sinks
+	if len(sinks) == 0 {
+		sinks = []Sink{defaultSink}
+	}
+	for _, sink := range sinks {
+		sink.Emit(rl.req.Context(), record)
+	}
 }
 
 // Header implements http.ResponseWriter.
@@ -260,6 +312,9 @@ func (rl *respLogger) Write(b []byte) (int, error) {
 	if rl.captureErrorOutput {
 		rl.Addf("logging error output: %q\n", string(b))
 	}
+	if rl.respBodyBuf != nil {
+		rl.respBodyBuf.Write(b)
+	}
 	return rl.w.Write(b)
 }
 
@@ -272,8 +327,9 @@ func (rl *respLogger) WriteHeader(status int) {
 func (rl *respLogger) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	rl.hijacked = true
 
-	// the outer ResponseWriter object returned by WrapForHTTP1Or2 implements
-	// http.Hijacker if the inner object (rl.w) implements http.Hijacker.
+	// the wrapper returned by wrapResponseWriter only implements http.Hijacker
+	// if the inner object (rl.w) implements http.Hijacker, and forwards here
+	// rather than straight to rl.w so the hijacked flag above gets recorded.
 	return rl.w.(http.Hijacker).Hijack()
 }
 
@@ -17,6 +17,9 @@ limitations under the License.
 package httplog
 
 import (
+	"bufio"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -159,41 +162,148 @@ func TestRespLoggerWithDecoratedResponseWriter(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 
-	var tw http.ResponseWriter = new(responsewriter.FakeResponseWriter)
-	_, rwGot := newLogged(req, tw)
+	var tw http.ResponseWriter
+	var rwGot http.ResponseWriter
 
-	switch v := rwGot.(type) {
-	case *respLogger:
-	default:
-		t.Errorf("Expected respLogger, got %v", reflect.TypeOf(v))
-	}
+	// Exercise every subset of {Flusher, CloseNotifier, Hijacker, Pusher,
+	// ReaderFrom} so that adding one of these by hand (as happened with
+	// Pusher) can't silently regress another.
+	for mask := 0; mask < 1<<5; mask++ {
+		wantFlusher := mask&1 != 0
+		wantCloseNotifier := mask&2 != 0
+		wantHijacker := mask&4 != 0
+		wantPusher := mask&8 != 0
+		wantReaderFrom := mask&16 != 0
 
-	tw = new(responsewriter.FakeResponseWriterFlusherCloseNotifier)
-	_, rwGot = newLogged(req, tw)
+		tw = newTestFakeResponseWriter(wantFlusher, wantCloseNotifier, wantHijacker, wantPusher, wantReaderFrom)
+		_, rwGot = newLogged(req, tw)
 
-	//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
-	if _, ok := rwGot.(http.CloseNotifier); !ok {
-		t.Errorf("Expected http.ResponseWriter to implement http.CloseNotifier")
+		if _, ok := rwGot.(http.Flusher); ok != wantFlusher {
+			t.Errorf("mask %05b: http.Flusher implemented=%v, want %v", mask, ok, wantFlusher)
+		}
+		//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+		if _, ok := rwGot.(http.CloseNotifier); ok != wantCloseNotifier {
+			t.Errorf("mask %05b: http.CloseNotifier implemented=%v, want %v", mask, ok, wantCloseNotifier)
+		}
+		if _, ok := rwGot.(http.Hijacker); ok != wantHijacker {
+			t.Errorf("mask %05b: http.Hijacker implemented=%v, want %v", mask, ok, wantHijacker)
+		}
+		if _, ok := rwGot.(http.Pusher); ok != wantPusher {
+			t.Errorf("mask %05b: http.Pusher implemented=%v, want %v", mask, ok, wantPusher)
+		}
+		if _, ok := rwGot.(io.ReaderFrom); ok != wantReaderFrom {
+			t.Errorf("mask %05b: io.ReaderFrom implemented=%v, want %v", mask, ok, wantReaderFrom)
+		}
+	}
+}
+
+// TestRespLoggerDoesNotGainHijackerFromPusher guards against the specific
+// regression this package used to be exposed to: respLogger itself always has
+// a Hijack method (so Log() can record whether a request was hijacked), so a
+// careless wrapper that embeds *respLogger directly would satisfy
+// http.Hijacker even when the underlying http.ResponseWriter does not.
+func TestRespLoggerDoesNotGainHijackerFromPusher(t *testing.T) {
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
 	}
-	if _, ok := rwGot.(http.Flusher); !ok {
-		t.Errorf("Expected the wrapper to implement http.Flusher")
+
+	tw := newTestFakeResponseWriter(false, false, false, true, false)
+	_, rwGot := newLogged(req, tw)
+
+	if _, ok := rwGot.(http.Pusher); !ok {
+		t.Errorf("Expected the wrapper to implement http.Pusher")
 	}
 	if _, ok := rwGot.(http.Hijacker); ok {
-		t.Errorf("Expected http.ResponseWriter not to implement http.Hijacker")
+		t.Errorf("Expected the wrapper not to implement http.Hijacker merely because it implements http.Pusher")
 	}
+}
 
-	tw = new(responsewriter.FakeResponseWriterFlusherCloseNotifierHijacker)
-	_, rwGot = newLogged(req, tw)
-
-	//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
-	if _, ok := rwGot.(http.CloseNotifier); !ok {
-		t.Errorf("Expected http.ResponseWriter to implement http.CloseNotifier")
+// newTestFakeResponseWriter returns a testFakeResponseWriter* value, typed as
+// a plain http.ResponseWriter, implementing exactly the requested optional
+// interfaces.
+func newTestFakeResponseWriter(flusher, closeNotifier, hijacker, pusher, readerFrom bool) http.ResponseWriter {
+	mask := 0
+	if flusher {
+		mask |= 1
+	}
+	if closeNotifier {
+		mask |= 2
 	}
-	if _, ok := rwGot.(http.Flusher); !ok {
-		t.Errorf("Expected the wrapper to implement http.Flusher")
+	if hijacker {
+		mask |= 4
 	}
-	if _, ok := rwGot.(http.Hijacker); !ok {
-		t.Errorf("Expected http.ResponseWriter to implement http.Hijacker")
+	if pusher {
+		mask |= 8
+	}
+	if readerFrom {
+		mask |= 16
+	}
+	switch mask {
+	case 0:
+		return new(testFakeResponseWriter)
+	case 1:
+		return new(testFakeResponseWriterFlusher)
+	case 2:
+		return new(testFakeResponseWriterCloseNotifier)
+	case 3:
+		return new(testFakeResponseWriterFlusherCloseNotifier)
+	case 4:
+		return new(testFakeResponseWriterHijacker)
+	case 5:
+		return new(testFakeResponseWriterFlusherHijacker)
+	case 6:
+		return new(testFakeResponseWriterCloseNotifierHijacker)
+	case 7:
+		return new(testFakeResponseWriterFlusherCloseNotifierHijacker)
+	case 8:
+		return new(testFakeResponseWriterPusher)
+	case 9:
+		return new(testFakeResponseWriterFlusherPusher)
+	case 10:
+		return new(testFakeResponseWriterCloseNotifierPusher)
+	case 11:
+		return new(testFakeResponseWriterFlusherCloseNotifierPusher)
+	case 12:
+		return new(testFakeResponseWriterHijackerPusher)
+	case 13:
+		return new(testFakeResponseWriterFlusherHijackerPusher)
+	case 14:
+		return new(testFakeResponseWriterCloseNotifierHijackerPusher)
+	case 15:
+		return new(testFakeResponseWriterFlusherCloseNotifierHijackerPusher)
+	case 16:
+		return new(testFakeResponseWriterReaderFrom)
+	case 17:
+		return new(testFakeResponseWriterFlusherReaderFrom)
+	case 18:
+		return new(testFakeResponseWriterCloseNotifierReaderFrom)
+	case 19:
+		return new(testFakeResponseWriterFlusherCloseNotifierReaderFrom)
+	case 20:
+		return new(testFakeResponseWriterHijackerReaderFrom)
+	case 21:
+		return new(testFakeResponseWriterFlusherHijackerReaderFrom)
+	case 22:
+		return new(testFakeResponseWriterCloseNotifierHijackerReaderFrom)
+	case 23:
+		return new(testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom)
+	case 24:
+		return new(testFakeResponseWriterPusherReaderFrom)
+	case 25:
+		return new(testFakeResponseWriterFlusherPusherReaderFrom)
+	case 26:
+		return new(testFakeResponseWriterCloseNotifierPusherReaderFrom)
+	case 27:
+		return new(testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom)
+	case 28:
+		return new(testFakeResponseWriterHijackerPusherReaderFrom)
+	case 29:
+		return new(testFakeResponseWriterFlusherHijackerPusherReaderFrom)
+	case 30:
+		return new(testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom)
+	default:
+		return new(testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom)
 	}
 }
 
@@ -207,3 +317,480 @@ func TestResponseWriterDecorator(t *testing.T) {
 		t.Errorf("Expected the decorator to return the inner http.ResponseWriter object")
 	}
 }
+
+// testFakeResponseWriter and its variants below are minimal stand-ins used
+// to exercise every subset of {Flusher, CloseNotifier, Hijacker, Pusher,
+// ReaderFrom} that wrapResponseWriter needs to dispatch on.
+type testFakeResponseWriter struct {
+	header http.Header
+}
+
+func (f *testFakeResponseWriter) Header() http.Header {
+	if f.header == nil {
+		f.header = http.Header{}
+	}
+	return f.header
+}
+
+func (f *testFakeResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+
+func (f *testFakeResponseWriter) WriteHeader(status int) {}
+
+// testFakeResponseWriterFlusher additionally implements Flusher.
+type testFakeResponseWriterFlusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusher) Flush() {}
+
+// testFakeResponseWriterCloseNotifier additionally implements CloseNotifier.
+type testFakeResponseWriterCloseNotifier struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifier) CloseNotify() <-chan bool {
+	return nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifier additionally implements Flusher, CloseNotifier.
+type testFakeResponseWriterFlusherCloseNotifier struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifier) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifier) CloseNotify() <-chan bool {
+	return nil
+}
+
+// testFakeResponseWriterHijacker additionally implements Hijacker.
+type testFakeResponseWriterHijacker struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+// testFakeResponseWriterFlusherHijacker additionally implements Flusher, Hijacker.
+type testFakeResponseWriterFlusherHijacker struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherHijacker) Flush() {}
+
+func (f *testFakeResponseWriterFlusherHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+// testFakeResponseWriterCloseNotifierHijacker additionally implements CloseNotifier, Hijacker.
+type testFakeResponseWriterCloseNotifierHijacker struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierHijacker) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierHijacker additionally implements Flusher, CloseNotifier, Hijacker.
+type testFakeResponseWriterFlusherCloseNotifierHijacker struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijacker) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierHijacker) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+// testFakeResponseWriterPusher additionally implements Pusher.
+type testFakeResponseWriterPusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterFlusherPusher additionally implements Flusher, Pusher.
+type testFakeResponseWriterFlusherPusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherPusher) Flush() {}
+
+func (f *testFakeResponseWriterFlusherPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterCloseNotifierPusher additionally implements CloseNotifier, Pusher.
+type testFakeResponseWriterCloseNotifierPusher struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierPusher) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierPusher additionally implements Flusher, CloseNotifier, Pusher.
+type testFakeResponseWriterFlusherCloseNotifierPusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierPusher) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierPusher) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterHijackerPusher additionally implements Hijacker, Pusher.
+type testFakeResponseWriterHijackerPusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterHijackerPusher) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterHijackerPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterFlusherHijackerPusher additionally implements Flusher, Hijacker, Pusher.
+type testFakeResponseWriterFlusherHijackerPusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherHijackerPusher) Flush() {}
+
+func (f *testFakeResponseWriterFlusherHijackerPusher) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterFlusherHijackerPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterCloseNotifierHijackerPusher additionally implements CloseNotifier, Hijacker, Pusher.
+type testFakeResponseWriterCloseNotifierHijackerPusher struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierHijackerPusher) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerPusher) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierHijackerPusher additionally implements Flusher, CloseNotifier, Hijacker, Pusher.
+type testFakeResponseWriterFlusherCloseNotifierHijackerPusher struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusher) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusher) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusher) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusher) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+// testFakeResponseWriterReaderFrom additionally implements ReaderFrom.
+type testFakeResponseWriterReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherReaderFrom additionally implements Flusher, ReaderFrom.
+type testFakeResponseWriterFlusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherReaderFrom) Flush() {}
+
+func (f *testFakeResponseWriterFlusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterCloseNotifierReaderFrom additionally implements CloseNotifier, ReaderFrom.
+type testFakeResponseWriterCloseNotifierReaderFrom struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierReaderFrom additionally implements Flusher, CloseNotifier, ReaderFrom.
+type testFakeResponseWriterFlusherCloseNotifierReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierReaderFrom) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterHijackerReaderFrom additionally implements Hijacker, ReaderFrom.
+type testFakeResponseWriterHijackerReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterHijackerReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterHijackerReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherHijackerReaderFrom additionally implements Flusher, Hijacker, ReaderFrom.
+type testFakeResponseWriterFlusherHijackerReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherHijackerReaderFrom) Flush() {}
+
+func (f *testFakeResponseWriterFlusherHijackerReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterFlusherHijackerReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterCloseNotifierHijackerReaderFrom additionally implements CloseNotifier, Hijacker, ReaderFrom.
+type testFakeResponseWriterCloseNotifierHijackerReaderFrom struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierHijackerReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom additionally implements Flusher, CloseNotifier, Hijacker, ReaderFrom.
+type testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterPusherReaderFrom additionally implements Pusher, ReaderFrom.
+type testFakeResponseWriterPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherPusherReaderFrom additionally implements Flusher, Pusher, ReaderFrom.
+type testFakeResponseWriterFlusherPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherPusherReaderFrom) Flush() {}
+
+func (f *testFakeResponseWriterFlusherPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterCloseNotifierPusherReaderFrom additionally implements CloseNotifier, Pusher, ReaderFrom.
+type testFakeResponseWriterCloseNotifierPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierPusherReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom additionally implements Flusher, CloseNotifier, Pusher, ReaderFrom.
+type testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterHijackerPusherReaderFrom additionally implements Hijacker, Pusher, ReaderFrom.
+type testFakeResponseWriterHijackerPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterHijackerPusherReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterHijackerPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterHijackerPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherHijackerPusherReaderFrom additionally implements Flusher, Hijacker, Pusher, ReaderFrom.
+type testFakeResponseWriterFlusherHijackerPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherHijackerPusherReaderFrom) Flush() {}
+
+func (f *testFakeResponseWriterFlusherHijackerPusherReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterFlusherHijackerPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherHijackerPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom additionally implements CloseNotifier, Hijacker, Pusher, ReaderFrom.
+type testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterCloseNotifierHijackerPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
+
+// testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom additionally implements Flusher, CloseNotifier, Hijacker, Pusher, ReaderFrom.
+type testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom struct {
+	testFakeResponseWriter
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom) Flush() {}
+
+//lint:file-ignore SA1019 Keep supporting deprecated http.CloseNotifier
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom) CloseNotify() <-chan bool {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom) Push(target string, opts *http.PushOptions) error {
+	return nil
+}
+
+func (f *testFakeResponseWriterFlusherCloseNotifierHijackerPusherReaderFrom) ReadFrom(r io.Reader) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2014 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+type requestIDKeyType int
+
+// RequestIDKey is the context.Context key under which WithLogging stores the
+// correlation ID for the current request, so downstream handlers, admission
+// plugins, and webhook clients can read it with RequestIDFrom and forward it
+// on (e.g. from a transport.RoundTripper wrapper), enabling end-to-end
+// correlation across kube-apiserver -> kubelet/webhook hops.
+const RequestIDKey requestIDKeyType = 0
+
+// RequestIDSource generates a request ID for requests that arrive without an
+// X-Request-ID header. Replace it to change how request IDs are produced,
+// e.g. to parse a W3C traceparent header instead of generating a fresh ID.
+// Not safe to change concurrently with serving requests.
+var RequestIDSource func(r *http.Request) string = defaultRequestIDSource
+
+func defaultRequestIDSource(r *http.Request) string {
+	return string(uuid.NewUUID())
+}
+
+// RequestIDFrom returns the request ID WithLogging generated or propagated
+// for this request, and whether one was found.
+func RequestIDFrom(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(RequestIDKey).(string)
+	return requestID, ok
+}
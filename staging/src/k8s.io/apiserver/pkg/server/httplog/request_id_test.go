@@ -0,0 +1,67 @@
+/*
+Copyright 2014 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithLoggingPropagatesInboundRequestID(t *testing.T) {
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("X-Request-ID", "inbound-id")
+
+	var got string
+	var ok bool
+	handler := WithLogging(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok = RequestIDFrom(r.Context())
+	}), DefaultStacktracePred)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if !ok || got != "inbound-id" {
+		t.Errorf("expected request ID %q propagated via context, got %q (ok=%v)", "inbound-id", got, ok)
+	}
+	if h := w.Header().Get("X-Request-ID"); h != "inbound-id" {
+		t.Errorf("expected X-Request-ID response header %q, got %q", "inbound-id", h)
+	}
+}
+
+func TestWithLoggingGeneratesRequestIDWhenAbsent(t *testing.T) {
+	old := RequestIDSource
+	defer func() { RequestIDSource = old }()
+	RequestIDSource = func(r *http.Request) string { return "generated-id" }
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	handler := WithLogging(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), DefaultStacktracePred)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if h := w.Header().Get("X-Request-ID"); h != "generated-id" {
+		t.Errorf("expected generated X-Request-ID response header %q, got %q", "generated-id", h)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2014 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReturnHandler is like http.Handler, but ServeHTTPReturn may return an
+// error instead of writing one to w itself. WithLoggingReturn takes care of
+// turning that error into a response and a structured log line, so handlers
+// don't each need to repeat that boilerplate.
+type ReturnHandler interface {
+	ServeHTTPReturn(w http.ResponseWriter, r *http.Request) error
+}
+
+// ReturnHandlerFunc adapts a function to a ReturnHandler.
+type ReturnHandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTPReturn implements ReturnHandler.
+func (f ReturnHandlerFunc) ServeHTTPReturn(w http.ResponseWriter, r *http.Request) error {
+	return f(w, r)
+}
+
+// HTTPError is an error that knows the status code and client-safe message it
+// should produce. Msg is written to the client; Err, if set, is only ever
+// logged, so it is safe to put request-internal detail there that shouldn't
+// leak to callers.
+type HTTPError struct {
+	Code int
+	Msg  string
+	Err  error
+}
+
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return e.Msg + ": " + e.Err.Error()
+	}
+	return e.Msg
+}
+
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorToStatus maps an error returned from a ReturnHandler to the HTTP
+// status code that should be written for it.
+type ErrorToStatus func(err error) int
+
+// DefaultErrorToStatus is the ErrorToStatus used by WithLoggingReturn unless
+// overridden with WithErrorToStatus.
+func DefaultErrorToStatus(err error) int {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	var statusErr *apierrors.StatusError
+	if errors.As(err, &statusErr) {
+		return int(statusErr.Status().Code)
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return 499 // client closed request, matching nginx's convention
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// returnHandlerOptions holds the configuration built up by ReturnOptions.
+type returnHandlerOptions struct {
+	errorToStatus ErrorToStatus
+	loggingOpts   []Option
+}
+
+// ReturnOption configures WithLoggingReturn.
+type ReturnOption func(*returnHandlerOptions)
+
+// WithErrorToStatus overrides the default error-to-status mapping used by
+// WithLoggingReturn.
+func WithErrorToStatus(f ErrorToStatus) ReturnOption {
+	return func(o *returnHandlerOptions) {
+		o.errorToStatus = f
+	}
+}
+
+// WithLoggingOptions forwards the given WithLogging options (e.g. WithSink,
+// WithBodyCapture) to the inner WithLogging call made by WithLoggingReturn.
+func WithLoggingOptions(opts ...Option) ReturnOption {
+	return func(o *returnHandlerOptions) {
+		o.loggingOpts = append(o.loggingOpts, opts...)
+	}
+}
+
+// WithLoggingReturn wraps h the same way WithLogging does, but additionally
+// handles the error ServeHTTPReturn returns: if no status has been written to
+// the response yet, the error is mapped to a status code and written, and
+// either way the error is attached to the request's log line via
+// AddKeyValue(ctx, "err", ...). This lets handlers stop juggling
+// responsewriters.ErrorNegotiated boilerplate at every call site.
+//
+// Use WithLoggingOptions to pass WithLogging options (e.g. WithSink,
+// WithBodyCapture) through to the inner WithLogging call.
+func WithLoggingReturn(h ReturnHandler, pred StacktracePred, opts ...ReturnOption) http.Handler {
+	o := &returnHandlerOptions{
+		errorToStatus: DefaultErrorToStatus,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	inner := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		err := h.ServeHTTPReturn(w, req)
+		if err == nil {
+			return
+		}
+
+		AddKeyValue(req.Context(), "err", err.Error())
+
+		if rl := respLoggerFromRequest(req); rl == nil || rl.statusRecorded || rl.hijacked {
+			// Something already wrote (or hijacked) the response; writing
+			// again would either panic or be silently ignored.
+			return
+		}
+
+		msg := err.Error()
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			msg = httpErr.Msg
+		}
+		http.Error(w, msg, o.errorToStatus(err))
+	})
+
+	return WithLogging(inner, pred, o.loggingOpts...)
+}
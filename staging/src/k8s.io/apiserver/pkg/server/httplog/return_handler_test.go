@@ -0,0 +1,131 @@
+/*
+Copyright 2014 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestDefaultErrorToStatus(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"HTTPError", &HTTPError{Code: http.StatusTeapot, Msg: "nope"}, http.StatusTeapot},
+		{"StatusError", apierrors.NewNotFound(schema.GroupResource{}, "foo"), http.StatusNotFound},
+		{"Canceled", context.Canceled, 499},
+		{"DeadlineExceeded", context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"other", errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DefaultErrorToStatus(tc.err); got != tc.want {
+				t.Errorf("DefaultErrorToStatus(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWithLoggingReturnWritesMappedStatus(t *testing.T) {
+	h := ReturnHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return &HTTPError{Code: http.StatusTeapot, Msg: "I'm a teapot"}
+	})
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	WithLoggingReturn(h, DefaultStacktracePred).ServeHTTP(w, req)
+
+	if w.Code != http.StatusTeapot {
+		t.Errorf("expected status %v, got %v", http.StatusTeapot, w.Code)
+	}
+}
+
+func TestWithLoggingReturnDoesNotOverwriteRecordedStatus(t *testing.T) {
+	h := ReturnHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		w.WriteHeader(http.StatusAccepted)
+		return errors.New("boom")
+	})
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	WithLoggingReturn(h, DefaultStacktracePred).ServeHTTP(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Errorf("expected status %v, got %v", http.StatusAccepted, w.Code)
+	}
+}
+
+func TestWithErrorToStatusOverride(t *testing.T) {
+	h := ReturnHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return errors.New("boom")
+	})
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	WithLoggingReturn(h, DefaultStacktracePred, WithErrorToStatus(func(error) int {
+		return http.StatusBadGateway
+	})).ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("expected status %v, got %v", http.StatusBadGateway, w.Code)
+	}
+}
+
+func TestWithLoggingOptionsForwardsSinks(t *testing.T) {
+	var got []Record
+	recordingSink := SinkFunc(func(ctx context.Context, record Record) {
+		got = append(got, record)
+	})
+
+	var loggedCtx context.Context
+	h := ReturnHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		loggedCtx = r.Context()
+		return nil
+	})
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	WithLoggingReturn(h, DefaultStacktracePred, WithLoggingOptions(WithSink(recordingSink))).ServeHTTP(w, req)
+
+	if rl := respLoggerFromContext(loggedCtx); rl != nil {
+		rl.Log()
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected WithLoggingOptions to register the sink on the inner WithLogging call, got %d records", len(got))
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2014 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httplog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCombinedLogFormatSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := CombinedLogFormatSink(&buf)
+
+	sink.Emit(context.Background(), Record{
+		Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Method:    "GET",
+		URI:       "/api/v1/pods",
+		Status:    200,
+		SrcIP:     "10.0.0.1:12345",
+		UserAgent: "test-agent",
+	})
+
+	got := buf.String()
+	for _, want := range []string{"10.0.0.1", `"GET /api/v1/pods HTTP/1.1"`, "200", `"test-agent"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected combined log line to contain %q, got %q", want, got)
+		}
+	}
+}
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := JSONSink(&buf)
+
+	sink.Emit(context.Background(), Record{
+		Method: "GET",
+		URI:    "/api/v1/pods",
+		Status: 200,
+		Extra:  map[string]interface{}{"custom": "value"},
+	})
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected valid JSON, got error: %v (line: %q)", err, buf.String())
+	}
+	if entry["URI"] != "/api/v1/pods" {
+		t.Errorf("expected URI field, got %v", entry["URI"])
+	}
+	if entry["custom"] != "value" {
+		t.Errorf("expected Extra entries to be flattened in, got %v", entry["custom"])
+	}
+}
+
+func TestWithSinkRegistersAdditionalSinks(t *testing.T) {
+	var got []Record
+	recordingSink := SinkFunc(func(ctx context.Context, record Record) {
+		got = append(got, record)
+	})
+	var buf bytes.Buffer
+
+	req, err := http.NewRequest("GET", "http://example.com/foo", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var loggedCtx context.Context
+	handler := WithLogging(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loggedCtx = r.Context()
+		w.WriteHeader(http.StatusTeapot)
+	}), DefaultStacktracePred, WithSink(recordingSink, JSONSink(&buf)))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	// Log() is only actually deferred when klog V(3) is enabled; call it
+	// directly so the test doesn't depend on the ambient verbosity.
+	if rl := respLoggerFromContext(loggedCtx); rl != nil {
+		rl.Log()
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected exactly one record, got %d", len(got))
+	}
+	if got[0].Status != http.StatusTeapot {
+		t.Errorf("expected status %v, got %v", http.StatusTeapot, got[0].Status)
+	}
+	if buf.Len() == 0 {
+		t.Errorf("expected JSONSink to have written a line")
+	}
+}